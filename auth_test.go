@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestRoleAtLeast(t *testing.T) {
+	cases := []struct {
+		role Role
+		min  Role
+		want bool
+	}{
+		{RoleOwner, RoleViewer, true},
+		{RoleOwner, RoleOwner, true},
+		{RoleAdmin, RoleOwner, false},
+		{RoleMember, RoleAdmin, false},
+		{RoleViewer, RoleViewer, true},
+		{Role("bogus"), RoleViewer, false},
+	}
+	for _, c := range cases {
+		if got := roleAtLeast(c.role, c.min); got != c.want {
+			t.Errorf("roleAtLeast(%q, %q) = %v, want %v", c.role, c.min, got, c.want)
+		}
+	}
+}
+
+func TestRoleOutranks(t *testing.T) {
+	cases := []struct {
+		role  Role
+		other Role
+		want  bool
+	}{
+		{RoleOwner, RoleAdmin, true},
+		{RoleAdmin, RoleOwner, false},
+		{RoleOwner, RoleOwner, false},
+		{RoleAdmin, RoleMember, true},
+		{Role("bogus"), RoleViewer, false},
+		{RoleOwner, Role("bogus"), false},
+	}
+	for _, c := range cases {
+		if got := roleOutranks(c.role, c.other); got != c.want {
+			t.Errorf("roleOutranks(%q, %q) = %v, want %v", c.role, c.other, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package cachepkg provides a small TTL/LRU cache with singleflight
+// deduplication of concurrent loads and pluggable cross-instance
+// invalidation, replacing the bare mutex-guarded map the server used to
+// keep inline.
+package cachepkg
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// InvalidationBus fans out key invalidations so multiple instances of the
+// server can agree a cached value is stale. Publish should be called
+// after the local cache has already evicted key; Subscribe registers the
+// callback used to evict on invalidations coming from elsewhere.
+type InvalidationBus interface {
+	Publish(ctx context.Context, key string) error
+	Subscribe(onInvalidate func(key string))
+}
+
+// InProcessBus is an InvalidationBus for single-instance deployments: it
+// simply calls subscribers directly, matching the cache's previous
+// behavior of only ever invalidating its own map.
+type InProcessBus struct {
+	mu          sync.Mutex
+	subscribers []func(key string)
+}
+
+func NewInProcessBus() *InProcessBus {
+	return &InProcessBus{}
+}
+
+func (b *InProcessBus) Publish(ctx context.Context, key string) error {
+	b.mu.Lock()
+	subscribers := append([]func(string){}, b.subscribers...)
+	b.mu.Unlock()
+
+	for _, sub := range subscribers {
+		sub(key)
+	}
+	return nil
+}
+
+func (b *InProcessBus) Subscribe(onInvalidate func(key string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, onInvalidate)
+}
+
+type entry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// Store is an LRU cache with a fixed TTL per entry. Concurrent misses for
+// the same key are collapsed via singleflight so a cold key under load
+// triggers one loader call instead of one per waiting request.
+type Store struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+	group    singleflight.Group
+	bus      InvalidationBus
+}
+
+// New creates a Store that evicts entries older than ttl or beyond
+// capacity, and wires itself up to receive invalidations from bus.
+func New(capacity int, ttl time.Duration, bus InvalidationBus) *Store {
+	s := &Store{
+		ttl:      ttl,
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+		bus:      bus,
+	}
+	bus.Subscribe(s.evictLocal)
+	return s
+}
+
+// Get returns the cached value for key, if present and unexpired.
+func (s *Store) Get(key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		s.removeElement(el)
+		return nil, false
+	}
+	s.order.MoveToFront(el)
+	return e.value, true
+}
+
+func (s *Store) set(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value = value
+		e.expiresAt = time.Now().Add(s.ttl)
+		s.order.MoveToFront(el)
+		return
+	}
+
+	el := s.order.PushFront(&entry{key: key, value: value, expiresAt: time.Now().Add(s.ttl)})
+	s.items[key] = el
+	if s.order.Len() > s.capacity {
+		s.removeElement(s.order.Back())
+	}
+}
+
+func (s *Store) removeElement(el *list.Element) {
+	if el == nil {
+		return
+	}
+	e := el.Value.(*entry)
+	delete(s.items, e.key)
+	s.order.Remove(el)
+}
+
+func (s *Store) evictLocal(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.items[key]; ok {
+		s.removeElement(el)
+	}
+}
+
+// Load returns the cached value for key, populating it via loader on a
+// miss.
+func (s *Store) Load(ctx context.Context, key string, loader func() (interface{}, error)) (interface{}, error) {
+	if value, ok := s.Get(key); ok {
+		return value, nil
+	}
+
+	value, err, _ := s.group.Do(key, func() (interface{}, error) {
+		v, err := loader()
+		if err != nil {
+			return nil, err
+		}
+		s.set(key, v)
+		return v, nil
+	})
+	return value, err
+}
+
+// Invalidate evicts key locally and publishes the invalidation over the
+// bus so other instances sharing it evict it too.
+func (s *Store) Invalidate(ctx context.Context, key string) error {
+	s.evictLocal(key)
+	return s.bus.Publish(ctx, key)
+}
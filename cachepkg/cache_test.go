@@ -0,0 +1,104 @@
+package cachepkg
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStoreTTLExpiry(t *testing.T) {
+	s := New(10, 20*time.Millisecond, NewInProcessBus())
+	ctx := context.Background()
+
+	if _, err := s.Load(ctx, "k", func() (interface{}, error) { return "v1", nil }); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if _, ok := s.Get("k"); !ok {
+		t.Fatal("expected fresh entry to be present")
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := s.Get("k"); ok {
+		t.Fatal("expected expired entry to be evicted")
+	}
+}
+
+func TestStoreLRUEviction(t *testing.T) {
+	s := New(2, time.Minute, NewInProcessBus())
+	ctx := context.Background()
+	load := func(v string) func() (interface{}, error) {
+		return func() (interface{}, error) { return v, nil }
+	}
+
+	s.Load(ctx, "a", load("a"))
+	s.Load(ctx, "b", load("b"))
+	s.Get("a") // touch "a" so "b" becomes the least recently used
+	s.Load(ctx, "c", load("c"))
+
+	if _, ok := s.Get("b"); ok {
+		t.Fatal("expected least recently used entry to be evicted")
+	}
+	if _, ok := s.Get("a"); !ok {
+		t.Fatal("expected recently used entry to survive eviction")
+	}
+	if _, ok := s.Get("c"); !ok {
+		t.Fatal("expected newly inserted entry to be present")
+	}
+}
+
+func TestStoreLoadSingleflight(t *testing.T) {
+	s := New(10, time.Minute, NewInProcessBus())
+	ctx := context.Background()
+
+	var calls int32
+	start := make(chan struct{})
+	results := make([]interface{}, 10)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			v, err := s.Load(ctx, "shared", func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return "v", nil
+			})
+			if err != nil {
+				t.Errorf("Load: %v", err)
+				return
+			}
+			results[i] = v
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("loader called %d times, want 1", got)
+	}
+	for i, v := range results {
+		if v != "v" {
+			t.Fatalf("result[%d] = %v, want %q", i, v, "v")
+		}
+	}
+}
+
+func TestStoreInvalidate(t *testing.T) {
+	s := New(10, time.Minute, NewInProcessBus())
+	ctx := context.Background()
+
+	if _, err := s.Load(ctx, "k", func() (interface{}, error) { return "v1", nil }); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if err := s.Invalidate(ctx, "k"); err != nil {
+		t.Fatalf("Invalidate: %v", err)
+	}
+	if _, ok := s.Get("k"); ok {
+		t.Fatal("expected invalidated entry to be evicted")
+	}
+}
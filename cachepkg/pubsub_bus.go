@@ -0,0 +1,42 @@
+package cachepkg
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub"
+)
+
+// PubSubBus is an InvalidationBus backed by a Cloud Pub/Sub topic, for
+// deployments running more than one server instance: an Invalidate call
+// on one instance publishes an invalidation that every other instance's
+// subscription delivers back to its own Store.
+type PubSubBus struct {
+	topic *pubsub.Topic
+	sub   *pubsub.Subscription
+}
+
+// NewPubSubBus wires a bus around an existing topic/subscription pair.
+// The subscription should be dedicated to this server (not shared with
+// unrelated consumers), since every message it receives is treated as a
+// cache-key invalidation.
+func NewPubSubBus(topic *pubsub.Topic, sub *pubsub.Subscription) *PubSubBus {
+	return &PubSubBus{topic: topic, sub: sub}
+}
+
+func (b *PubSubBus) Publish(ctx context.Context, key string) error {
+	result := b.topic.Publish(ctx, &pubsub.Message{Data: []byte(key)})
+	_, err := result.Get(ctx)
+	return err
+}
+
+// Subscribe starts a background receive loop that evicts onInvalidate for
+// every message delivered, until ctx passed to the loop's Receive call is
+// canceled (i.e. for the lifetime of the process).
+func (b *PubSubBus) Subscribe(onInvalidate func(key string)) {
+	go func() {
+		_ = b.sub.Receive(context.Background(), func(ctx context.Context, m *pubsub.Message) {
+			onInvalidate(string(m.Data))
+			m.Ack()
+		})
+	}()
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"proto-buff/proto/circleoftrustmembers"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcAddr is the second port the gRPC CircleOfTrustService listens on,
+// alongside the REST API on the main HTTP port.
+const grpcAddr = "0.0.0.0:1001"
+
+// circleOfTrustGRPCServer adapts circleOfTrustService to the
+// CircleOfTrustService gRPC surface generated from the
+// circleoftrustmembers proto package, so REST and gRPC share the same
+// validation, authorization, and caching instead of each reimplementing
+// it.
+type circleOfTrustGRPCServer struct {
+	circleoftrustmembers.UnimplementedCircleOfTrustServiceServer
+}
+
+func (s *circleOfTrustGRPCServer) CreateUser(ctx context.Context, req *circleoftrustmembers.CreateUserRequest) (*circleoftrustmembers.CreateUserResponse, error) {
+	if httpStatus, err := circleService.CreateUser(ctx, req.GetUserName()); err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+	return &circleoftrustmembers.CreateUserResponse{}, nil
+}
+
+func (s *circleOfTrustGRPCServer) CreateCircle(ctx context.Context, req *circleoftrustmembers.CreateCircleRequest) (*circleoftrustmembers.CreateCircleResponse, error) {
+	if httpStatus, err := circleService.CreateCircle(ctx, req.GetOwnerId(), req.GetCircleOfTrustName()); err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+	return &circleoftrustmembers.CreateCircleResponse{}, nil
+}
+
+func (s *circleOfTrustGRPCServer) AddUserToCircle(ctx context.Context, req *circleoftrustmembers.AddUserToCircleRequest) (*circleoftrustmembers.AddUserToCircleResponse, error) {
+	httpStatus, err := circleService.AddUserToCircle(ctx, callerUserID(ctx), req.GetCircleOfTrustId(), req.GetUserId(), Role(req.GetRole()))
+	if err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+	return &circleoftrustmembers.AddUserToCircleResponse{}, nil
+}
+
+func (s *circleOfTrustGRPCServer) RemoveUserFromCircle(ctx context.Context, req *circleoftrustmembers.RemoveUserFromCircleRequest) (*circleoftrustmembers.RemoveUserFromCircleResponse, error) {
+	httpStatus, err := circleService.RemoveUserFromCircle(ctx, callerUserID(ctx), req.GetCircleOfTrustId(), req.GetUserId())
+	if err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+	return &circleoftrustmembers.RemoveUserFromCircleResponse{}, nil
+}
+
+func (s *circleOfTrustGRPCServer) CheckMembership(ctx context.Context, req *circleoftrustmembers.CheckMembershipRequest) (*circleoftrustmembers.CheckMembershipResponse, error) {
+	isMember, httpStatus, err := circleService.CheckMembership(ctx, callerUserID(ctx), req.GetCircleOfTrustId(), req.GetUserId())
+	if err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+	return &circleoftrustmembers.CheckMembershipResponse{IsMember: isMember}, nil
+}
+
+func (s *circleOfTrustGRPCServer) ListUsersInCircle(ctx context.Context, req *circleoftrustmembers.ListUsersInCircleRequest) (*circleoftrustmembers.ListUsersInCircleResponse, error) {
+	users, nextPageToken, httpStatus, err := circleService.ListUsersInCircle(ctx, callerUserID(ctx), req.GetCircleOfTrustId(), int(req.GetPageSize()), req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(grpcCodeForHTTPStatus(httpStatus), err.Error())
+	}
+
+	resp := &circleoftrustmembers.ListUsersInCircleResponse{NextPageToken: nextPageToken}
+	for _, u := range users {
+		resp.Users = append(resp.Users, &circleoftrustmembers.User{Id: u.ID, Name: u.Name})
+	}
+	return resp, nil
+}
+
+// grpcCodeForHTTPStatus maps the http.Status values circleOfTrustService
+// returns to the closest grpc code, so REST and gRPC callers see
+// equivalent failure semantics for the same error.
+func grpcCodeForHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusGone:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// authUnaryInterceptor parses the bearer token from incoming gRPC
+// metadata the same way authenticate does for HTTP requests, and
+// populates callerUserIDKey on the context so circleService's
+// requireRole checks see the same caller identity on both transports. A
+// missing token is not rejected here, matching authenticate: handlers
+// that require a known caller already reject a zero-valued ID via
+// requireRole.
+func authUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return handler(ctx, req)
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return handler(ctx, req)
+	}
+
+	tokenString := strings.TrimPrefix(values[0], "Bearer ")
+	if tokenString == values[0] {
+		return nil, status.Error(codes.Unauthenticated, "authorization metadata must use the Bearer scheme")
+	}
+
+	userID, err := parseBearerToken(tokenString)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return handler(context.WithValue(ctx, callerUserIDKey, userID), req)
+}
+
+// startGRPCServer starts the CircleOfTrustService, plus a standard
+// grpc_health_v1 health service so orchestrators can probe readiness, on
+// grpcAddr. It runs alongside the REST API's net/http mux rather than
+// replacing it.
+func startGRPCServer() (*grpc.Server, error) {
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authUnaryInterceptor))
+	circleoftrustmembers.RegisterCircleOfTrustServiceServer(grpcServer, &circleOfTrustGRPCServer{})
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	go func() {
+		logger.Info("gRPC server running", zap.String("addr", grpcAddr))
+		if err := grpcServer.Serve(lis); err != nil {
+			logger.Warn("gRPC server stopped", zap.Error(err))
+		}
+	}()
+
+	return grpcServer, nil
+}
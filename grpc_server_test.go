@@ -0,0 +1,28 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCCodeForHTTPStatus(t *testing.T) {
+	cases := []struct {
+		httpStatus int
+		want       codes.Code
+	}{
+		{http.StatusBadRequest, codes.InvalidArgument},
+		{http.StatusUnauthorized, codes.Unauthenticated},
+		{http.StatusForbidden, codes.PermissionDenied},
+		{http.StatusNotFound, codes.NotFound},
+		{http.StatusGone, codes.FailedPrecondition},
+		{http.StatusInternalServerError, codes.Internal},
+		{http.StatusTeapot, codes.Internal},
+	}
+	for _, c := range cases {
+		if got := grpcCodeForHTTPStatus(c.httpStatus); got != c.want {
+			t.Errorf("grpcCodeForHTTPStatus(%d) = %v, want %v", c.httpStatus, got, c.want)
+		}
+	}
+}
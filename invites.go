@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	inviteCodeBytes  = 8
+	defaultInviteTTL = 24 * time.Hour
+)
+
+var (
+	errInviteNotFound  = errors.New("invite code not found")
+	errInviteExpired   = errors.New("invite code has expired")
+	errInviteExhausted = errors.New("invite code has reached its maximum uses")
+)
+
+// generateInviteCode returns a short, crypto/rand-backed base32 code
+// suitable for sharing out-of-band (no padding, upper-case for easy
+// transcription).
+func generateInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf), nil
+}
+
+// generateInviteHandler creates a short-lived, limited-use code that lets
+// anyone holding it join the circle via joinCircleHandler, without an
+// admin adding them directly.
+func generateInviteHandler(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		CircleOfTrustId int64 `json:"circle_of_trust_id"`
+		MaxUses         int64 `json:"max_uses"`
+		TtlSeconds      int64 `json:"ttl_seconds"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	if request.MaxUses <= 0 {
+		request.MaxUses = 1
+	}
+	ttl := defaultInviteTTL
+	if request.TtlSeconds > 0 {
+		ttl = time.Duration(request.TtlSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
+	defer cancel()
+	ctx = withRequestFields(ctx, request.CircleOfTrustId, callerUserID(ctx))
+
+	if status, err := requireRole(ctx, request.CircleOfTrustId, callerUserID(ctx), RoleAdmin); err != nil {
+		failRequest(ctx, w, "failed to generate invite code", err, status)
+		return
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		logAndFail(ctx, w, "failed to generate invite code", err, http.StatusInternalServerError)
+		return
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	mutation := spanner.Insert(
+		"CircleInvites",
+		[]string{"Code", "CircleOfTrustId", "ExpiresAt", "MaxUses", "Uses"},
+		[]interface{}{code, request.CircleOfTrustId, expiresAt, request.MaxUses, int64(0)},
+	)
+	if _, err := client.Apply(ctx, []*spanner.Mutation{mutation}); err != nil {
+		logAndFail(ctx, w, "failed to create invite", err, http.StatusInternalServerError)
+		return
+	}
+
+	jsonResponse(w, map[string]string{
+		"code":       code,
+		"expires_at": expiresAt.Format(time.RFC3339),
+	}, http.StatusOK)
+}
+
+// joinCircleHandler redeems an invite code, adding the authenticated
+// caller (never an arbitrary client-supplied user ID) to the circle as
+// a member. The read-check-write of the invite and the member-list
+// update happen inside a single ReadWriteTransaction so concurrent
+// redemptions of a code can't both succeed past MaxUses.
+func joinCircleHandler(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	request.Code = strings.TrimSpace(request.Code)
+	if request.Code == "" {
+		http.Error(w, "code is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
+	defer cancel()
+
+	userID := callerUserID(ctx)
+	ctx = withRequestFields(ctx, 0, userID)
+	if userID == 0 {
+		http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	var circleID int64
+	var memberCount int
+	_, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, "CircleInvites", spanner.Key{request.Code}, []string{"CircleOfTrustId", "ExpiresAt", "MaxUses", "Uses"})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return errInviteNotFound
+			}
+			return err
+		}
+
+		var maxUses, uses int64
+		var expiresAt time.Time
+		if err := row.Columns(&circleID, &expiresAt, &maxUses, &uses); err != nil {
+			return err
+		}
+		if time.Now().After(expiresAt) {
+			return errInviteExpired
+		}
+		if uses >= maxUses {
+			return errInviteExhausted
+		}
+
+		members, err := readCircleMembersTxn(ctx, txn, circleID)
+		if err != nil {
+			return err
+		}
+		if _, alreadyMember := members.Roles[userID]; alreadyMember {
+			memberCount = len(members.Roles)
+			return nil
+		}
+		members.Roles[userID] = string(RoleMember)
+		memberCount = len(members.Roles)
+
+		serialized, err := proto.Marshal(members)
+		if err != nil {
+			return err
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.InsertOrUpdate("CircleOfTrustMembers", []string{"CircleOfTrustId", "Members", "LastUpdated"}, []interface{}{circleID, serialized, spanner.CommitTimestamp}),
+			spanner.Update("CircleInvites", []string{"Code", "Uses"}, []interface{}{request.Code, uses + 1}),
+		})
+	})
+
+	if err != nil {
+		if status, ok := inviteErrorStatus(err); ok {
+			http.Error(w, err.Error(), status)
+		} else {
+			logAndFail(ctx, w, "failed to join circle", err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	circleSize.WithLabelValues(fmt.Sprintf("%d", circleID)).Set(float64(memberCount))
+	invalidateCache(ctx, fmt.Sprintf("%d", circleID))
+
+	jsonResponse(w, map[string]string{"msg": "Joined circle of trust"}, http.StatusOK)
+}
+
+// inviteErrorStatus maps the sentinel errors a redemption transaction can
+// fail with to the HTTP status joinCircleHandler should report; ok is
+// false for anything else, which the caller treats as an internal error.
+func inviteErrorStatus(err error) (status int, ok bool) {
+	switch err {
+	case errInviteNotFound:
+		return http.StatusNotFound, true
+	case errInviteExpired, errInviteExhausted:
+		return http.StatusGone, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGenerateInviteCode(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		code, err := generateInviteCode()
+		if err != nil {
+			t.Fatalf("generateInviteCode() returned error: %v", err)
+		}
+		if code == "" {
+			t.Fatal("generateInviteCode() returned an empty code")
+		}
+		if seen[code] {
+			t.Fatalf("generateInviteCode() produced a duplicate code: %q", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestInviteErrorStatus(t *testing.T) {
+	cases := []struct {
+		err      error
+		wantOK   bool
+		wantCode int
+	}{
+		{errInviteNotFound, true, http.StatusNotFound},
+		{errInviteExpired, true, http.StatusGone},
+		{errInviteExhausted, true, http.StatusGone},
+		{errors.New("some internal failure"), false, 0},
+	}
+	for _, c := range cases {
+		status, ok := inviteErrorStatus(c.err)
+		if ok != c.wantOK {
+			t.Errorf("inviteErrorStatus(%v) ok = %v, want %v", c.err, ok, c.wantOK)
+		}
+		if ok && status != c.wantCode {
+			t.Errorf("inviteErrorStatus(%v) status = %d, want %d", c.err, status, c.wantCode)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// errUserNotMember is returned by a withCircleMembers mutator when the
+// target user has no entry in the circle's role map.
+var errUserNotMember = errors.New("user is not a member of this circle")
+
+// CircleOfTrustMembersProto tracks the membership of a circle as a map of
+// UserId to Role, rather than a bare list of IDs, so that a member's
+// privilege level travels with the membership record itself.
+type CircleOfTrustMembersProto struct {
+	Roles map[int64]string `protobuf:"bytes,2,rep,name=roles,json=roles" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// MemberIds returns the user IDs present in the circle. Order is not
+// guaranteed.
+func (m *CircleOfTrustMembersProto) MemberIds() []int64 {
+	ids := make([]int64, 0, len(m.Roles))
+	for id := range m.Roles {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// loadCircleMembers reads and deserializes the member/role map for
+// circleID outside of any transaction. A circle with no rows yet is
+// returned as an empty, non-nil proto so callers can populate Roles
+// directly.
+func loadCircleMembers(ctx context.Context, circleID int64) (*CircleOfTrustMembersProto, error) {
+	defer observeSpannerLatency("loadCircleMembers", time.Now())
+
+	stmt := spanner.Statement{
+		SQL:    `SELECT Members FROM CircleOfTrustMembers WHERE CircleOfTrustId = @circle_id`,
+		Params: map[string]interface{}{"circle_id": circleID},
+	}
+	iter := client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	members := &CircleOfTrustMembersProto{Roles: make(map[int64]string)}
+
+	row, err := iter.Next()
+	if err != nil {
+		if err == iterator.Done {
+			return members, nil
+		}
+		return nil, err
+	}
+
+	var serialized []byte
+	if err := row.Columns(&serialized); err != nil {
+		return nil, err
+	}
+	if len(serialized) > 0 {
+		if err := proto.Unmarshal(serialized, members); err != nil {
+			return nil, err
+		}
+	}
+	if members.Roles == nil {
+		members.Roles = make(map[int64]string)
+	}
+	return members, nil
+}
+
+// readCircleMembersTxn mirrors loadCircleMembers but reads within an
+// in-flight ReadWriteTransaction so the read participates in its
+// transaction's locking and commit.
+func readCircleMembersTxn(ctx context.Context, txn *spanner.ReadWriteTransaction, circleID int64) (*CircleOfTrustMembersProto, error) {
+	defer observeSpannerLatency("readCircleMembersTxn", time.Now())
+
+	members := &CircleOfTrustMembersProto{Roles: make(map[int64]string)}
+
+	row, err := txn.ReadRow(ctx, "CircleOfTrustMembers", spanner.Key{circleID}, []string{"Members"})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return members, nil
+		}
+		return nil, err
+	}
+
+	var serialized []byte
+	if err := row.Columns(&serialized); err != nil {
+		return nil, err
+	}
+	if len(serialized) > 0 {
+		if err := proto.Unmarshal(serialized, members); err != nil {
+			return nil, err
+		}
+	}
+	if members.Roles == nil {
+		members.Roles = make(map[int64]string)
+	}
+	return members, nil
+}
+
+// withCircleMembers runs mutate against the current member/role map for
+// circleID inside a single ReadWriteTransaction and writes the result
+// back, stamping LastUpdated with the transaction's commit timestamp.
+// This is the one place add/remove/set-role should touch
+// CircleOfTrustMembers so their read-modify-write is consistently
+// transactional rather than racing on a Single().Query + InsertOrUpdate.
+func withCircleMembers(ctx context.Context, circleID int64, mutate func(*CircleOfTrustMembersProto) error) error {
+	defer observeSpannerLatency("withCircleMembers", time.Now())
+
+	var memberCount int
+	_, err := client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		members, err := readCircleMembersTxn(ctx, txn, circleID)
+		if err != nil {
+			return err
+		}
+
+		if err := mutate(members); err != nil {
+			return err
+		}
+		memberCount = len(members.Roles)
+
+		serialized, err := proto.Marshal(members)
+		if err != nil {
+			return err
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.InsertOrUpdate(
+				"CircleOfTrustMembers",
+				[]string{"CircleOfTrustId", "Members", "LastUpdated"},
+				[]interface{}{circleID, serialized, spanner.CommitTimestamp},
+			),
+		})
+	})
+	if err == nil {
+		circleSize.WithLabelValues(fmt.Sprintf("%d", circleID)).Set(float64(memberCount))
+	}
+	return err
+}
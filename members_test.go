@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestMemberIds(t *testing.T) {
+	members := &CircleOfTrustMembersProto{Roles: map[int64]string{
+		1: string(RoleOwner),
+		2: string(RoleMember),
+		3: string(RoleViewer),
+	}}
+
+	ids := members.MemberIds()
+	if len(ids) != len(members.Roles) {
+		t.Fatalf("MemberIds() returned %d ids, want %d", len(ids), len(members.Roles))
+	}
+
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for id := range members.Roles {
+		if !seen[id] {
+			t.Errorf("MemberIds() missing id %d", id)
+		}
+	}
+}
+
+func TestMemberIdsEmpty(t *testing.T) {
+	members := &CircleOfTrustMembersProto{Roles: map[int64]string{}}
+	if ids := members.MemberIds(); len(ids) != 0 {
+		t.Errorf("MemberIds() = %v, want empty slice", ids)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	requestCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "circle_of_trust_http_requests_total",
+		Help: "Total HTTP requests handled, by handler and response status.",
+	}, []string{"handler", "status"})
+
+	requestLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "circle_of_trust_http_request_duration_seconds",
+		Help:    "HTTP handler latency in seconds, by handler.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"handler"})
+
+	spannerQueryLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "circle_of_trust_spanner_query_duration_seconds",
+		Help:    "Spanner query/transaction latency in seconds, by operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	cacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "circle_of_trust_cache_hits_total",
+		Help: "Membership cache hits, by handler.",
+	}, []string{"handler"})
+
+	cacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "circle_of_trust_cache_misses_total",
+		Help: "Membership cache misses, by handler.",
+	}, []string{"handler"})
+
+	circleSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "circle_of_trust_circle_size",
+		Help: "Number of members in a circle as of its last write.",
+	}, []string{"circle_id"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		requestCount,
+		requestLatency,
+		spannerQueryLatency,
+		cacheHits,
+		cacheMisses,
+		circleSize,
+	)
+}
+
+// observeSpannerLatency records how long a Spanner operation took under
+// operation's name. Call as defer observeSpannerLatency("name", time.Now()).
+func observeSpannerLatency(operation string, start time.Time) {
+	spannerQueryLatency.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// statusRecorder wraps http.ResponseWriter to capture the status code a
+// handler writes, so wrapHandler can label requestCount with it after the
+// handler returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
@@ -2,25 +2,50 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"sync"
+	"sort"
+	"strconv"
 	"time"
 
-	"proto-buff/proto/circleoftrustmembers" // Correct import path
+	"proto-buff/cachepkg"
 
+	"cloud.google.com/go/pubsub"
 	"cloud.google.com/go/spanner"
-	"google.golang.org/protobuf/proto" // Required protobuf import
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
 )
 
+const (
+	defaultListPageSize = 50
+	maxListPageSize     = 200
+)
+
+// encodePageToken and decodePageToken turn an offset into
+// hydrateCircleMembers' sorted member slice into an opaque cursor, so
+// callers don't depend on the offset being a raw integer.
+func encodePageToken(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(raw))
+}
+
 var (
-	membersProto circleoftrustmembers.CircleOfTrustMembersProto // Correct struct type
-	projectID    = "tiger-on-cloud"
-	instanceID   = "spanner-db"
-	databaseID   = "cot-db"
-	client       *spanner.Client
+	projectID  = "tiger-on-cloud"
+	instanceID = "spanner-db"
+	databaseID = "cot-db"
+	client     *spanner.Client
 )
 
 // User struct represents basic user data
@@ -29,40 +54,69 @@ type User struct {
 	Name string `json:"name"`
 }
 
-// Cache structure for concurrency-safe caching
+// membershipCache holds one member list per circle, keyed by
+// circleMembersCacheKey, which both the "is user X a member of circle Y"
+// and the "list of users in circle Y" lookups read through. Misses are
+// deduplicated via singleflight inside the
+// store, and cacheBus fans invalidations out to every instance sharing it.
 var (
-	cache      = make(map[string]cachedItem)
-	cacheMutex sync.Mutex
+	cacheBus        = newCacheBus()
+	membershipCache = cachepkg.New(1000, time.Minute, cacheBus)
 )
 
-// Cached data and its timestamp
-type cachedItem struct {
-	value     []User
-	timestamp time.Time
-}
+// newCacheBus selects the InvalidationBus implementation for
+// membershipCache. CACHE_BUS_MODE=pubsub wires a Cloud Pub/Sub-backed
+// bus so multiple server instances invalidate each other's caches;
+// anything else (the default) keeps invalidations in-process, which is
+// all a single-instance deployment needs.
+func newCacheBus() cachepkg.InvalidationBus {
+	if envOrDefault("CACHE_BUS_MODE", "in-process") != "pubsub" {
+		return cachepkg.NewInProcessBus()
+	}
 
-func getFromCache(key string) ([]User, bool) {
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
+	ctx := context.Background()
+	pubsubClient, err := pubsub.NewClient(ctx, envOrDefault("CACHE_BUS_PUBSUB_PROJECT_ID", projectID))
+	if err != nil {
+		logger.Fatal("failed to create pubsub client for cache bus", zap.Error(err))
+	}
+	topic := pubsubClient.Topic(envOrDefault("CACHE_BUS_PUBSUB_TOPIC", "circle-of-trust-cache-invalidation"))
+	sub := pubsubClient.Subscription(envOrDefault("CACHE_BUS_PUBSUB_SUBSCRIPTION", "circle-of-trust-cache-invalidation-sub"))
+	return cachepkg.NewPubSubBus(topic, sub)
+}
 
-	item, exists := cache[key]
-	if !exists || time.Since(item.timestamp) > time.Minute {
-		return nil, false
+func invalidateCache(ctx context.Context, key string) {
+	if err := membershipCache.Invalidate(ctx, key); err != nil {
+		loggerFromContext(ctx).Warn("failed to invalidate cache key", zap.String("key", key), zap.Error(err))
 	}
-	return item.value, true
 }
 
-func setInCache(key string, value []User) {
-	cacheMutex.Lock()
-	defer cacheMutex.Unlock()
-	cache[key] = cachedItem{
-		value:     value,
-		timestamp: time.Now(),
+// logAndFail logs err at Error level with whatever contextual fields ctx's
+// logger carries (request ID, handler, circle/user ID) and responds to the
+// caller with msg, so internal details like Spanner statuses don't leak
+// into HTTP responses while still being fully captured server-side.
+func logAndFail(ctx context.Context, w http.ResponseWriter, msg string, err error, status int) {
+	loggerFromContext(ctx).Error(msg, zap.Error(err))
+	http.Error(w, msg, status)
+}
+
+// failRequest surfaces a circleOfTrustService/requireRole error to the
+// caller. Those return 5xx for unexpected failures (a wrapped Spanner
+// error, say), which genericMsg stands in for via logAndFail so the
+// wrapped detail never reaches the response; they return 4xx for
+// validation/authorization failures, which are already caller-safe
+// messages (e.g. "role %q does not permit this action") safe to send
+// as-is.
+func failRequest(ctx context.Context, w http.ResponseWriter, genericMsg string, err error, status int) {
+	if status >= http.StatusInternalServerError {
+		logAndFail(ctx, w, genericMsg, err, status)
+		return
 	}
+	http.Error(w, err.Error(), status)
 }
 
 // Main entrypoint
 func main() {
+	defer logger.Sync()
 	isLoaded()
 
 	ctx := context.Background()
@@ -70,34 +124,63 @@ func main() {
 	var err error
 	client, err = spanner.NewClient(ctx, dbPath)
 	if err != nil {
-		log.Fatalf("Failed to create Spanner client: %v", err)
+		logger.Fatal("failed to create Spanner client", zap.Error(err))
 	}
 	defer client.Close()
 
-	http.HandleFunc("/", wrapHandler(indexHandler))
-	http.HandleFunc("/api/spanner/create/user", wrapHandler(createUserHandler))
-	http.HandleFunc("/api/spanner/create/circle", wrapHandler(createCircleOfTrustHandler))
-	http.HandleFunc("/api/spanner/add_to_circle", wrapHandler(addUserToCircleHandler))
-	http.HandleFunc("/api/spanner/remove_from_circle", wrapHandler(removeUserFromCircleHandler))
-	http.HandleFunc("/api/spanner/check_membership", wrapHandler(checkUserMembershipHandler))
-	http.HandleFunc("/api/spanner/list_users_in_circle", wrapHandler(listUsersInCircleHandler))
+	http.HandleFunc("/", wrapHandler("index", indexHandler))
+	http.HandleFunc("/api/spanner/create/user", wrapHandler("create_user", createUserHandler))
+	http.HandleFunc("/api/spanner/create/circle", wrapHandler("create_circle", createCircleOfTrustHandler))
+	http.HandleFunc("/api/spanner/add_to_circle", wrapHandler("add_to_circle", addUserToCircleHandler))
+	http.HandleFunc("/api/spanner/remove_from_circle", wrapHandler("remove_from_circle", removeUserFromCircleHandler))
+	http.HandleFunc("/api/spanner/check_membership", wrapHandler("check_membership", checkUserMembershipHandler))
+	http.HandleFunc("/api/spanner/list_users_in_circle", wrapHandler("list_users_in_circle", listUsersInCircleHandler))
+	http.HandleFunc("/api/spanner/circle/set_role", wrapHandler("set_circle_role", setCircleRoleHandler))
+	http.HandleFunc("/api/spanner/circle/leave", wrapHandler("leave_circle", leaveCircleHandler))
+	http.HandleFunc("/api/spanner/circle/generate_invite", wrapHandler("generate_invite", generateInviteHandler))
+	http.HandleFunc("/api/spanner/circle/join", wrapHandler("join_circle", joinCircleHandler))
+	http.Handle("/metrics", promhttp.Handler())
+
+	grpcServer, err := startGRPCServer()
+	if err != nil {
+		logger.Fatal("failed to start gRPC server", zap.Error(err))
+	}
+	defer grpcServer.GracefulStop()
 
-	log.Println("Server running at http://0.0.0.0:1000")
+	logger.Info("server running", zap.String("addr", "http://0.0.0.0:1000"))
 	if err := http.ListenAndServe("0.0.0.0:1000", nil); err != nil {
-		log.Fatalf("Server failed: %v", err)
+		logger.Fatal("server failed", zap.Error(err))
 	}
 }
 
-// Middleware wraps handler to catch panics
-func wrapHandler(next http.HandlerFunc) http.HandlerFunc {
+// wrapHandler catches panics, populates the caller's user ID (from a
+// bearer token, if present) onto the request context, and records
+// per-handler request count/latency metrics and a request-scoped logger
+// carrying request_id and handler fields. name identifies the handler in
+// both logs and metrics.
+func wrapHandler(name string, next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		reqLogger := logger.With(zap.String("request_id", generateRequestID()), zap.String("handler", name))
+		r = r.WithContext(contextWithLogger(r.Context(), reqLogger))
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
 		defer func() {
 			if err := recover(); err != nil {
-				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-				log.Printf("Recovered from panic: %v", err)
+				reqLogger.Error("panic recovered", zap.Any("panic", err), zap.StackSkip("stack", 1))
+				http.Error(rec, "Internal Server Error", http.StatusInternalServerError)
 			}
+			requestCount.WithLabelValues(name, strconv.Itoa(rec.status)).Inc()
+			requestLatency.WithLabelValues(name).Observe(time.Since(start).Seconds())
 		}()
-		next.ServeHTTP(w, r)
+
+		authed, err := authenticate(r)
+		if err != nil {
+			http.Error(rec, fmt.Sprintf("Unauthorized: %v", err), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(rec, authed)
 	}
 }
 
@@ -108,7 +191,7 @@ func jsonResponse(w http.ResponseWriter, data interface{}, status int) {
 }
 
 func isLoaded() {
-	log.Printf("Loaded Project: %s", projectID)
+	logger.Info("loaded project", zap.String("project_id", projectID))
 }
 
 // Add your other handlers here, e.g., createCircleOfTrustHandler, etc.
@@ -125,18 +208,11 @@ func createUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
 
-	// Insert user into CicleOfTrustUsers
-	mutation := spanner.Insert(
-		"CicleOfTrustUsers",
-		[]string{"UserName"},
-		[]interface{}{user.Name},
-	)
-	_, err := client.Apply(ctx, []*spanner.Mutation{mutation})
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to insert user: %v", err), http.StatusInternalServerError)
+	if status, err := circleService.CreateUser(ctx, user.Name); err != nil {
+		failRequest(ctx, w, "failed to create user", err, status)
 		return
 	}
 
@@ -154,18 +230,12 @@ func createCircleOfTrustHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
+	ctx = withRequestFields(ctx, 0, request.OwnerId)
 
-	// Insert a circle of trust into CicleOfTrust
-	mutation := spanner.Insert(
-		"CicleOfTrust",
-		[]string{"OwnerId", "CicleOfTrustName"},
-		[]interface{}{request.OwnerId, request.CicleOfTrustName},
-	)
-	_, err := client.Apply(ctx, []*spanner.Mutation{mutation})
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to create circle of trust: %v", err), http.StatusInternalServerError)
+	if status, err := circleService.CreateCircle(ctx, request.OwnerId, request.CicleOfTrustName); err != nil {
+		failRequest(ctx, w, "failed to create circle of trust", err, status)
 		return
 	}
 
@@ -183,26 +253,15 @@ func removeUserFromCircleHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
+	ctx = withRequestFields(ctx, request.CicleOfTrustId, callerUserID(ctx))
 
-	// Delete user from CicleOfTrustMembers
-	mutation := spanner.Delete(
-		"CicleOfTrustMembers",
-		spanner.Key{request.CicleOfTrustId, request.UserId},
-	)
-	_, err := client.Apply(ctx, []*spanner.Mutation{mutation})
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to remove user from circle: %v", err), http.StatusInternalServerError)
+	if status, err := circleService.RemoveUserFromCircle(ctx, callerUserID(ctx), request.CicleOfTrustId, request.UserId); err != nil {
+		failRequest(ctx, w, "failed to remove user from circle of trust", err, status)
 		return
 	}
 
-	// Clear cached list of users for the circle
-	cacheKey := fmt.Sprintf("%d", request.CicleOfTrustId)
-	cacheMutex.Lock()
-	delete(cache, cacheKey) // Invalidate cache
-	cacheMutex.Unlock()
-
 	jsonResponse(w, map[string]string{"msg": "User removed from circle of trust"}, http.StatusOK)
 }
 
@@ -217,69 +276,28 @@ func checkUserMembershipHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cacheKey := fmt.Sprintf("%d-%d", request.CicleOfTrustId, request.UserId)
-	// Check if the membership status is cached
-	if result, found := getFromCache(cacheKey); found {
-		// Return cached result
-		if len(result) > 0 {
-			jsonResponse(w, map[string]string{"msg": "User is a member"}, http.StatusOK)
-		} else {
-			jsonResponse(w, map[string]string{"msg": "User is not a member"}, http.StatusOK)
-		}
-		return
-	}
-
-	// Otherwise, check the database
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
+	ctx = withRequestFields(ctx, request.CicleOfTrustId, callerUserID(ctx))
 
-	// Query CicleOfTrustMembers to check membership
-	stmt := spanner.Statement{
-		SQL: `SELECT COUNT(*) FROM CicleOfTrustMembers 
-		      WHERE CicleOfTrustId = @circle_id AND UserId = @user_id`,
-		Params: map[string]interface{}{
-			"circle_id": request.CicleOfTrustId,
-			"user_id":   request.UserId,
-		},
-	}
-	iter := client.Single().Query(ctx, stmt)
-	defer iter.Stop()
-
-	var count int64
-	row, err := iter.Next()
+	isMember, status, err := circleService.CheckMembership(ctx, callerUserID(ctx), request.CicleOfTrustId, request.UserId)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to check membership: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if err := row.Columns(&count); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to parse row: %v", err), http.StatusInternalServerError)
+		failRequest(ctx, w, "failed to check membership", err, status)
 		return
 	}
 
-	// Store the result in the cache
-	var result []User
-	if count > 0 {
-		result = append(result, User{ID: fmt.Sprintf("%d", request.UserId)})
-	}
-	setInCache(cacheKey, result)
-
-	// Respond
-	if count > 0 {
+	if isMember {
 		jsonResponse(w, map[string]string{"msg": "User is a member"}, http.StatusOK)
 	} else {
 		jsonResponse(w, map[string]string{"msg": "User is not a member"}, http.StatusOK)
 	}
 }
 
-type CircleOfTrustMembersProto struct {
-	MemberIds []int64 `protobuf:"varint,1,rep,name=member_ids,json=memberIds"`
-}
-
 func addUserToCircleHandler(w http.ResponseWriter, r *http.Request) {
 	var request struct {
 		CircleOfTrustId int64 `json:"circle_of_trust_id"`
 		UserId          int64 `json:"user_id"`
+		Role            Role  `json:"role"`
 	}
 
 	// Decode the request
@@ -288,148 +306,207 @@ func addUserToCircleHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
+	ctx = withRequestFields(ctx, request.CircleOfTrustId, callerUserID(ctx))
 
-	// Retrieve existing member list (if exists)
-	stmt := spanner.Statement{
-		SQL: `SELECT Members FROM CircleOfTrustMembers WHERE CircleOfTrustId = @circle_id`,
-		Params: map[string]interface{}{
-			"circle_id": request.CircleOfTrustId,
-		},
+	if status, err := circleService.AddUserToCircle(ctx, callerUserID(ctx), request.CircleOfTrustId, request.UserId, request.Role); err != nil {
+		failRequest(ctx, w, "failed to add user to circle of trust", err, status)
+		return
 	}
-	iter := client.Single().Query(ctx, stmt)
-	defer iter.Stop()
 
-	var existingMembers []byte
-	row, err := iter.Next()
-	if err == nil {
-		if err := row.Columns(&existingMembers); err != nil {
-			http.Error(w, fmt.Sprintf("Failed to parse row: %v", err), http.StatusInternalServerError)
-			return
-		}
-	}
+	jsonResponse(w, map[string]string{"msg": "User added to circle of trust"}, http.StatusOK)
+}
 
-	// Deserialize the existing members if any
-	var membersProto CircleOfTrustMembersProto
-	if len(existingMembers) > 0 {
-		if err := proto.Unmarshal(existingMembers, &membersProto); err != nil {
-			http.Error(w, fmt.Sprintf("Failed to unmarshal members: %v", err), http.StatusInternalServerError)
-			return
-		}
+// setCircleRoleHandler changes an existing member's role. Only owners may
+// grant or revoke the owner/admin roles; admins may adjust member/viewer.
+func setCircleRoleHandler(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		CircleOfTrustId int64 `json:"circle_of_trust_id"`
+		UserId          int64 `json:"user_id"`
+		Role            Role  `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
+		return
+	}
+	if _, ok := roleRank[request.Role]; !ok {
+		http.Error(w, "Invalid role", http.StatusBadRequest)
+		return
 	}
 
-	// Append the new member
-	membersProto.MemberIds = append(membersProto.MemberIds, request.UserId)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
+	defer cancel()
+	ctx = withRequestFields(ctx, request.CircleOfTrustId, callerUserID(ctx))
 
-	// Serialize the updated member list to Protobuf
-	serializedMembers, err := proto.Marshal(&membersProto)
+	minRole := RoleAdmin
+	if request.Role == RoleOwner || request.Role == RoleAdmin {
+		minRole = RoleOwner
+	}
+	if status, err := requireRole(ctx, request.CircleOfTrustId, callerUserID(ctx), minRole); err != nil {
+		failRequest(ctx, w, "failed to set role", err, status)
+		return
+	}
+	callerRole, err := getCallerRole(ctx, request.CircleOfTrustId, callerUserID(ctx))
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to serialize members: %v", err), http.StatusInternalServerError)
+		logAndFail(ctx, w, "failed to load caller role", err, http.StatusInternalServerError)
 		return
 	}
 
-	// Insert the user into CircleOfTrustMembers
-	mutation := spanner.InsertOrUpdate(
-		"CircleOfTrustMembers",
-		[]string{"CircleOfTrustId", "Members"},
-		[]interface{}{request.CircleOfTrustId, serializedMembers},
-	)
-	_, err = client.Apply(ctx, []*spanner.Mutation{mutation})
+	err = withCircleMembers(ctx, request.CircleOfTrustId, func(members *CircleOfTrustMembersProto) error {
+		current, ok := members.Roles[request.UserId]
+		if !ok {
+			return errUserNotMember
+		}
+		if !roleOutranks(callerRole, Role(current)) {
+			return errInsufficientRank
+		}
+		members.Roles[request.UserId] = string(request.Role)
+		return nil
+	})
+	if err == errUserNotMember {
+		http.Error(w, "User is not a member of this circle", http.StatusNotFound)
+		return
+	}
+	if err == errInsufficientRank {
+		http.Error(w, "caller's role does not outrank the target member's current role", http.StatusForbidden)
+		return
+	}
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to add user to circle: %v", err), http.StatusInternalServerError)
+		logAndFail(ctx, w, "failed to set role", err, http.StatusInternalServerError)
 		return
 	}
 
-	// Clear the cache
 	cacheKey := fmt.Sprintf("%d", request.CircleOfTrustId)
-	cacheMutex.Lock()
-	delete(cache, cacheKey) // Invalidate cache
-	cacheMutex.Unlock()
+	invalidateCache(ctx, cacheKey)
 
-	jsonResponse(w, map[string]string{"msg": "User added to circle of trust"}, http.StatusOK)
+	jsonResponse(w, map[string]string{"msg": "Role updated"}, http.StatusOK)
 }
 
-func listUsersInCircleHandler(w http.ResponseWriter, r *http.Request) {
+// leaveCircleHandler removes the caller from a circle. Unlike
+// removeUserFromCircleHandler, no admin role is required: any member may
+// leave of their own accord.
+func leaveCircleHandler(w http.ResponseWriter, r *http.Request) {
 	var request struct {
 		CircleOfTrustId int64 `json:"circle_of_trust_id"`
 	}
-
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		http.Error(w, "Invalid input", http.StatusBadRequest)
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
+	defer cancel()
+
+	userID := callerUserID(ctx)
+	ctx = withRequestFields(ctx, request.CircleOfTrustId, userID)
+	if userID == 0 {
+		http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+		return
+	}
+
+	err := withCircleMembers(ctx, request.CircleOfTrustId, func(members *CircleOfTrustMembersProto) error {
+		if _, ok := members.Roles[userID]; !ok {
+			return errUserNotMember
+		}
+		delete(members.Roles, userID)
+		return nil
+	})
+	if err == errUserNotMember {
+		http.Error(w, "User is not a member of this circle", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logAndFail(ctx, w, "failed to leave circle", err, http.StatusInternalServerError)
+		return
+	}
+
 	cacheKey := fmt.Sprintf("%d", request.CircleOfTrustId)
-	// Check if the list of users is cached
-	if users, found := getFromCache(cacheKey); found {
-		// Return cached result
-		jsonResponse(w, map[string][]User{"users": users}, http.StatusOK)
+	invalidateCache(ctx, cacheKey)
+
+	jsonResponse(w, map[string]string{"msg": "Left circle of trust"}, http.StatusOK)
+}
+
+func listUsersInCircleHandler(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		CircleOfTrustId int64 `json:"circle_of_trust_id"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid input", http.StatusBadRequest)
 		return
 	}
 
-	// Otherwise, query the database
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Second)
+	ctx, cancel := context.WithTimeout(r.Context(), 100*time.Second)
 	defer cancel()
+	ctx = withRequestFields(ctx, request.CircleOfTrustId, callerUserID(ctx))
 
-	stmt := spanner.Statement{
-		SQL:    `SELECT Members FROM CircleOfTrustMembers WHERE CircleOfTrustId = @circle_id`,
-		Params: map[string]interface{}{"circle_id": request.CircleOfTrustId},
+	pageSize := defaultListPageSize
+	if raw := r.URL.Query().Get("page_size"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			pageSize = n
+		}
 	}
-	iter := client.Single().Query(ctx, stmt)
-	defer iter.Stop()
-
-	var serializedMembers []byte
-	var membersProto CircleOfTrustMembersProto
 
-	row, err := iter.Next()
+	users, nextPageToken, status, err := circleService.ListUsersInCircle(ctx, callerUserID(ctx), request.CircleOfTrustId, pageSize, r.URL.Query().Get("page_token"))
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to list users: %v", err), http.StatusInternalServerError)
+		failRequest(ctx, w, "failed to list users", err, status)
 		return
 	}
 
-	// Get the serialized members
-	if err := row.Columns(&serializedMembers); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to parse row: %v", err), http.StatusInternalServerError)
-		return
+	response := map[string]interface{}{"users": users}
+	if nextPageToken != "" {
+		response["next_page_token"] = nextPageToken
 	}
+	jsonResponse(w, response, http.StatusOK)
+}
 
-	// Deserialize Protobuf data into a struct
-	if err := proto.Unmarshal(serializedMembers, &membersProto); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to unmarshal members: %v", err), http.StatusInternalServerError)
-		return
+// hydrateCircleMembers loads circleID's member/role map and resolves the
+// member IDs to Users in a single batch query, rather than the N+1
+// per-member round trip this used to do. A member ID with no
+// CircleOfTrustUsers row is left out of the result. Members are returned
+// sorted by UserId so pagination offsets stay stable across calls.
+func hydrateCircleMembers(ctx context.Context, circleID int64) ([]User, error) {
+	membersProto, err := loadCircleMembers(ctx, circleID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Fetch user details for each member ID
-	var users []User
-	for _, userID := range membersProto.MemberIds {
-		// Query user information
-		stmt := spanner.Statement{
-			SQL:    `SELECT UserName FROM CircleOfTrustUsers WHERE UserId = @user_id`,
-			Params: map[string]interface{}{"user_id": userID},
-		}
-		userIter := client.Single().Query(ctx, stmt)
-		defer userIter.Stop()
-
-		userRow, err := userIter.Next()
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to fetch user data: %v", err), http.StatusInternalServerError)
-			return
-		}
+	ids := membersProto.MemberIds()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) == 0 {
+		return []User{}, nil
+	}
 
-		var user User
-		if err := userRow.Columns(&user.Name); err != nil {
-			http.Error(w, fmt.Sprintf("Failed to parse row: %v", err), http.StatusInternalServerError)
-			return
-		}
+	defer observeSpannerLatency("hydrateCircleMembers", time.Now())
 
-		user.ID = fmt.Sprintf("%d", userID)
-		users = append(users, user)
+	stmt := spanner.Statement{
+		SQL:    `SELECT UserId, UserName FROM CircleOfTrustUsers WHERE UserId IN UNNEST(@ids)`,
+		Params: map[string]interface{}{"ids": ids},
 	}
+	iter := client.Single().Query(ctx, stmt)
+	defer iter.Stop()
 
-	// Cache the result
-	setInCache(cacheKey, users)
+	names := make(map[int64]string, len(ids))
+	if err := iter.Do(func(row *spanner.Row) error {
+		var id int64
+		var name string
+		if err := row.Columns(&id, &name); err != nil {
+			return err
+		}
+		names[id] = name
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-	// Return the users
-	jsonResponse(w, map[string][]User{"users": users}, http.StatusOK)
+	users := make([]User, 0, len(ids))
+	for _, id := range ids {
+		name, ok := names[id]
+		if !ok {
+			continue
+		}
+		users = append(users, User{ID: fmt.Sprintf("%d", id), Name: name})
+	}
+	return users, nil
 }
@@ -0,0 +1,39 @@
+package main
+
+import "testing"
+
+func TestEncodeDecodePageTokenRoundTrip(t *testing.T) {
+	offsets := []int{0, 1, 50, 12345}
+	for _, offset := range offsets {
+		token := encodePageToken(offset)
+		got, err := decodePageToken(token)
+		if err != nil {
+			t.Fatalf("decodePageToken(%q) returned error: %v", token, err)
+		}
+		if got != offset {
+			t.Errorf("decodePageToken(encodePageToken(%d)) = %d, want %d", offset, got, offset)
+		}
+	}
+}
+
+func TestDecodePageTokenEmpty(t *testing.T) {
+	offset, err := decodePageToken("")
+	if err != nil {
+		t.Fatalf("decodePageToken(\"\") returned error: %v", err)
+	}
+	if offset != 0 {
+		t.Errorf("decodePageToken(\"\") = %d, want 0", offset)
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	cases := []string{
+		"not-valid-base64!!",
+		"aGVsbG8=", // valid base64, but decodes to "hello", not an integer
+	}
+	for _, token := range cases {
+		if _, err := decodePageToken(token); err == nil {
+			t.Errorf("decodePageToken(%q) = nil error, want an error", token)
+		}
+	}
+}
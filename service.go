@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+
+	"cloud.google.com/go/spanner"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// circleOfTrustService holds the business logic behind both the REST
+// handlers in server.go and the gRPC service in grpc_server.go, so the
+// two transports share validation, authorization, and caching instead of
+// each reimplementing it. It carries no state of its own: client,
+// membershipCache, and cacheBus are all package-level, matching how the
+// REST handlers already used them.
+type circleOfTrustService struct{}
+
+var circleService = &circleOfTrustService{}
+
+// CreateUser inserts a new user row. Returns the http status REST should
+// surface; grpcCodeForHTTPStatus in grpc_server.go translates that to a
+// grpc code.
+func (s *circleOfTrustService) CreateUser(ctx context.Context, name string) (int, error) {
+	mutation := spanner.Insert(
+		"CicleOfTrustUsers",
+		[]string{"UserName"},
+		[]interface{}{name},
+	)
+	if _, err := client.Apply(ctx, []*spanner.Mutation{mutation}); err != nil {
+		loggerFromContext(ctx).Error("failed to insert user", zap.Error(err))
+		return http.StatusInternalServerError, fmt.Errorf("failed to insert user: %w", err)
+	}
+	return http.StatusOK, nil
+}
+
+// maxCreateCircleAttempts bounds how many times CreateCircle will
+// generate a fresh id and retry after colliding with an existing
+// CircleOfTrustId, so a run of bad luck fails loudly instead of looping
+// forever.
+const maxCreateCircleAttempts = 5
+
+// CreateCircle inserts a new circle of trust row and bootstraps the
+// owner's membership, so the creator isn't locked out of every
+// subsequent mutation by a circle whose Roles map starts out empty. The
+// id is generated in application code and inserted explicitly rather
+// than read back afterwards: spanner.Insert mutations don't return
+// generated column values, and re-querying "the latest row for this
+// owner and name" races with a concurrent create of the same
+// owner/name, which can attach the owner role to the wrong circle.
+func (s *circleOfTrustService) CreateCircle(ctx context.Context, ownerID int64, name string) (int, error) {
+	var circleID int64
+	for attempt := 0; ; attempt++ {
+		id, err := generateCircleID()
+		if err != nil {
+			loggerFromContext(ctx).Error("failed to generate circle of trust id", zap.Error(err))
+			return http.StatusInternalServerError, fmt.Errorf("failed to generate circle of trust id: %w", err)
+		}
+
+		mutation := spanner.Insert(
+			"CicleOfTrust",
+			[]string{"CircleOfTrustId", "OwnerId", "CicleOfTrustName"},
+			[]interface{}{id, ownerID, name},
+		)
+		if _, err := client.Apply(ctx, []*spanner.Mutation{mutation}); err != nil {
+			if spanner.ErrCode(err) == codes.AlreadyExists && attempt < maxCreateCircleAttempts-1 {
+				continue
+			}
+			loggerFromContext(ctx).Error("failed to create circle of trust", zap.Error(err))
+			return http.StatusInternalServerError, fmt.Errorf("failed to create circle of trust: %w", err)
+		}
+		circleID = id
+		break
+	}
+
+	if err := withCircleMembers(ctx, circleID, func(members *CircleOfTrustMembersProto) error {
+		members.Roles[ownerID] = string(RoleOwner)
+		return nil
+	}); err != nil {
+		loggerFromContext(ctx).Error("failed to bootstrap circle owner role", zap.Error(err))
+		return http.StatusInternalServerError, fmt.Errorf("failed to bootstrap circle owner role: %w", err)
+	}
+	return http.StatusOK, nil
+}
+
+// generateCircleID returns a random, crypto/rand-backed positive 63-bit
+// id for a new circle of trust.
+func generateCircleID() (int64, error) {
+	var buf [8]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		return 0, err
+	}
+	id := int64(binary.BigEndian.Uint64(buf[:]) & (1<<63 - 1))
+	if id == 0 {
+		id = 1
+	}
+	return id, nil
+}
+
+// AddUserToCircle grants userID the given role in circleID. The caller
+// must already hold RoleAdmin or better in the circle, and must outrank
+// the role being granted (an Admin can't hand out RoleOwner).
+func (s *circleOfTrustService) AddUserToCircle(ctx context.Context, callerID, circleID, userID int64, role Role) (int, error) {
+	if role == "" {
+		role = RoleMember
+	}
+	if _, ok := roleRank[role]; !ok {
+		return http.StatusBadRequest, fmt.Errorf("invalid role %q", role)
+	}
+	if status, err := requireRole(ctx, circleID, callerID, RoleAdmin); err != nil {
+		return status, err
+	}
+	callerRole, err := getCallerRole(ctx, circleID, callerID)
+	if err != nil {
+		loggerFromContext(ctx).Error("failed to load caller role", zap.Error(err))
+		return http.StatusInternalServerError, fmt.Errorf("failed to load caller role: %w", err)
+	}
+	if !roleOutranks(callerRole, role) {
+		return http.StatusForbidden, fmt.Errorf("role %q cannot grant role %q", callerRole, role)
+	}
+
+	if err := withCircleMembers(ctx, circleID, func(members *CircleOfTrustMembersProto) error {
+		if current, isMember := members.Roles[userID]; isMember && !roleOutranks(callerRole, Role(current)) {
+			return errInsufficientRank
+		}
+		members.Roles[userID] = string(role)
+		return nil
+	}); err != nil {
+		if err == errInsufficientRank {
+			return http.StatusForbidden, fmt.Errorf("role %q cannot change the role of a member it does not outrank", callerRole)
+		}
+		loggerFromContext(ctx).Error("failed to add user to circle", zap.Error(err))
+		return http.StatusInternalServerError, fmt.Errorf("failed to add user to circle: %w", err)
+	}
+
+	invalidateCache(ctx, fmt.Sprintf("%d", circleID))
+	return http.StatusOK, nil
+}
+
+// RemoveUserFromCircle revokes userID's membership in circleID. The
+// caller must already hold RoleAdmin or better in the circle, and must
+// outrank the target's current role (an Admin can't remove an Owner).
+func (s *circleOfTrustService) RemoveUserFromCircle(ctx context.Context, callerID, circleID, userID int64) (int, error) {
+	if status, err := requireRole(ctx, circleID, callerID, RoleAdmin); err != nil {
+		return status, err
+	}
+	callerRole, err := getCallerRole(ctx, circleID, callerID)
+	if err != nil {
+		loggerFromContext(ctx).Error("failed to load caller role", zap.Error(err))
+		return http.StatusInternalServerError, fmt.Errorf("failed to load caller role: %w", err)
+	}
+
+	var targetRole Role
+	mutateErr := withCircleMembers(ctx, circleID, func(members *CircleOfTrustMembersProto) error {
+		current, isMember := members.Roles[userID]
+		if !isMember {
+			return nil
+		}
+		targetRole = Role(current)
+		if !roleOutranks(callerRole, targetRole) {
+			return errInsufficientRank
+		}
+		delete(members.Roles, userID)
+		return nil
+	})
+	if mutateErr == errInsufficientRank {
+		return http.StatusForbidden, fmt.Errorf("role %q cannot remove a member with role %q", callerRole, targetRole)
+	}
+	if mutateErr != nil {
+		loggerFromContext(ctx).Error("failed to remove user from circle", zap.Error(mutateErr))
+		return http.StatusInternalServerError, fmt.Errorf("failed to remove user from circle: %w", mutateErr)
+	}
+
+	invalidateCache(ctx, fmt.Sprintf("%d", circleID))
+	return http.StatusOK, nil
+}
+
+// circleMembersCacheKey is the one cache entry per circle that both
+// CheckMembership and ListUsersInCircle read through, so a single
+// invalidateCache(ctx, circleID) call after any mutation covers both
+// instead of leaving a second, compound-keyed entry stale.
+func circleMembersCacheKey(circleID int64) string {
+	return fmt.Sprintf("%d", circleID)
+}
+
+// loadCachedCircleUsers returns circleID's full member list, populating
+// the shared per-circle cache entry on a miss.
+func loadCachedCircleUsers(ctx context.Context, circleID int64, metricLabel string) ([]User, error) {
+	cacheKey := circleMembersCacheKey(circleID)
+	if _, hit := membershipCache.Get(cacheKey); hit {
+		cacheHits.WithLabelValues(metricLabel).Inc()
+	} else {
+		cacheMisses.WithLabelValues(metricLabel).Inc()
+	}
+	value, err := membershipCache.Load(ctx, cacheKey, func() (interface{}, error) {
+		return hydrateCircleMembers(ctx, circleID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]User), nil
+}
+
+// CheckMembership reports whether userID belongs to circleID. The caller
+// must hold at least RoleViewer in the circle.
+func (s *circleOfTrustService) CheckMembership(ctx context.Context, callerID, circleID, userID int64) (bool, int, error) {
+	if status, err := requireRole(ctx, circleID, callerID, RoleViewer); err != nil {
+		return false, status, err
+	}
+
+	users, err := loadCachedCircleUsers(ctx, circleID, "check_membership")
+	if err != nil {
+		loggerFromContext(ctx).Error("failed to check membership", zap.Error(err))
+		return false, http.StatusInternalServerError, fmt.Errorf("failed to check membership: %w", err)
+	}
+
+	target := fmt.Sprintf("%d", userID)
+	for _, u := range users {
+		if u.ID == target {
+			return true, http.StatusOK, nil
+		}
+	}
+	return false, http.StatusOK, nil
+}
+
+// ListUsersInCircle returns one page of circleID's members. The caller
+// must hold at least RoleViewer in the circle.
+func (s *circleOfTrustService) ListUsersInCircle(ctx context.Context, callerID, circleID int64, pageSize int, pageToken string) ([]User, string, int, error) {
+	if status, err := requireRole(ctx, circleID, callerID, RoleViewer); err != nil {
+		return nil, "", status, err
+	}
+
+	users, err := loadCachedCircleUsers(ctx, circleID, "list_users_in_circle")
+	if err != nil {
+		loggerFromContext(ctx).Error("failed to list users", zap.Error(err))
+		return nil, "", http.StatusInternalServerError, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	if pageSize > maxListPageSize {
+		pageSize = maxListPageSize
+	}
+
+	offset, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", http.StatusBadRequest, fmt.Errorf("invalid page_token: %w", err)
+	}
+	if offset < 0 || offset > len(users) {
+		offset = len(users)
+	}
+	end := offset + pageSize
+	if end > len(users) {
+		end = len(users)
+	}
+
+	nextPageToken := ""
+	if end < len(users) {
+		nextPageToken = encodePageToken(end)
+	}
+	return users[offset:end], nextPageToken, http.StatusOK, nil
+}